@@ -0,0 +1,90 @@
+package gphoto2go
+
+// #cgo pkg-config: libgphoto2
+// #include <gphoto2.h>
+// #include <stdlib.h>
+import "C"
+import (
+	"io"
+	"runtime"
+	"unsafe"
+)
+
+// CameraFile wraps a native CameraFile buffer, e.g. one downloaded after a
+// CameraEvent reports EventFileAdded. The underlying C memory is released
+// via gp_file_unref through a finalizer, so callers don't have to remember
+// to free it; Bytes and WriteTo expose that memory directly instead of
+// copying it onto the Go heap first.
+type CameraFile struct {
+	cFile *C.CameraFile
+}
+
+func newCameraFile(cFile *C.CameraFile) *CameraFile {
+	f := &CameraFile{cFile: cFile}
+	runtime.SetFinalizer(f, func(f *CameraFile) {
+		C.gp_file_unref(f.cFile)
+	})
+	return f
+}
+
+// DownloadFile fetches the named file from the given camera folder, as
+// reported by a CameraEvent or a directory listing.
+func (c *Camera) DownloadFile(folder, name string) (*CameraFile, error) {
+	var cFile *C.CameraFile
+	if err := cameraResultToError(C.gp_file_new(&cFile)); err != nil {
+		return nil, err
+	}
+
+	cFolder := C.CString(folder)
+	defer C.free(unsafe.Pointer(cFolder))
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	c.mu.Lock()
+	err := cameraResultToError(C.gp_camera_file_get(
+		c.cCamera, cFolder, cName, C.GP_FILE_TYPE_NORMAL, cFile, c.cContext))
+	c.mu.Unlock()
+	if err != nil {
+		C.gp_file_unref(cFile)
+		return nil, err
+	}
+
+	return newCameraFile(cFile), nil
+}
+
+// Bytes returns the file's contents as a []byte backed directly by the
+// underlying C buffer, with no copy. The slice is only valid while f is
+// reachable; callers that hang onto it beyond f's last use must call
+// runtime.KeepAlive(f) themselves, mirroring the discipline cgo-backed
+// buffers generally require.
+func (f *CameraFile) Bytes() ([]byte, error) {
+	var cData *C.char
+	var cSize C.ulong
+	if err := cameraResultToError(C.gp_file_get_data_and_size(f.cFile, &cData, &cSize)); err != nil {
+		return nil, err
+	}
+
+	b := unsafe.Slice((*byte)(unsafe.Pointer(cData)), int(cSize))
+	runtime.KeepAlive(f)
+	return b, nil
+}
+
+// WriteTo streams the file's contents to w straight from the underlying C
+// buffer, for zero-copy transfers to disk or over HTTP.
+func (f *CameraFile) WriteTo(w io.Writer) (int64, error) {
+	b, err := f.Bytes()
+	if err != nil {
+		return 0, err
+	}
+	defer runtime.KeepAlive(f)
+
+	n, err := w.Write(b)
+	return int64(n), err
+}
+
+// Close releases the underlying CameraFile immediately rather than waiting
+// for the garbage collector to run the finalizer.
+func (f *CameraFile) Close() error {
+	runtime.SetFinalizer(f, nil)
+	return cameraResultToError(C.gp_file_unref(f.cFile))
+}
@@ -0,0 +1,262 @@
+package gphoto2go
+
+// #cgo pkg-config: libgphoto2
+// #include <gphoto2.h>
+// #include <stdlib.h>
+import "C"
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"unsafe"
+)
+
+// WidgetType mirrors libgphoto2's CameraWidgetType.
+type WidgetType int
+
+const (
+	WidgetWindow  WidgetType = C.GP_WIDGET_WINDOW
+	WidgetSection WidgetType = C.GP_WIDGET_SECTION
+	WidgetText    WidgetType = C.GP_WIDGET_TEXT
+	WidgetRange   WidgetType = C.GP_WIDGET_RANGE
+	WidgetToggle  WidgetType = C.GP_WIDGET_TOGGLE
+	WidgetRadio   WidgetType = C.GP_WIDGET_RADIO
+	WidgetMenu    WidgetType = C.GP_WIDGET_MENU
+	WidgetButton  WidgetType = C.GP_WIDGET_BUTTON
+	WidgetDate    WidgetType = C.GP_WIDGET_DATE
+)
+
+// ConfigWidget wraps a node of the camera's CameraWidget configuration
+// tree. The root widget owns the whole tree; child widgets borrow it and
+// must not outlive it.
+type ConfigWidget struct {
+	cWidget *C.CameraWidget
+	root    *C.CameraWidget
+	camera  *Camera
+}
+
+// Config fetches the camera's current configuration tree. The returned
+// widget owns the whole tree and must be released with Close once the
+// caller is done with it (or any of its children), mirroring CameraFile's
+// finalizer-backed lifetime.
+func (c *Camera) Config() (*ConfigWidget, error) {
+	var cRoot *C.CameraWidget
+	c.mu.Lock()
+	err := cameraResultToError(C.gp_camera_get_config(c.cCamera, &cRoot, c.cContext))
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &ConfigWidget{cWidget: cRoot, root: cRoot, camera: c}
+	runtime.SetFinalizer(w, func(w *ConfigWidget) {
+		C.gp_widget_free(w.root)
+	})
+
+	return w, nil
+}
+
+// Close releases the entire configuration tree this widget belongs to, not
+// just this widget -- child widgets obtained from Children or resolved via
+// Get/Set become invalid once the root widget returned by Config is closed.
+func (w *ConfigWidget) Close() error {
+	runtime.SetFinalizer(w, nil)
+	return cameraResultToError(C.gp_widget_free(w.root))
+}
+
+// Name returns the widget's short identifier (e.g. "iso").
+func (w *ConfigWidget) Name() (string, error) {
+	var cName *C.char
+	if err := cameraResultToError(C.gp_widget_get_name(w.cWidget, &cName)); err != nil {
+		return "", err
+	}
+	return C.GoString(cName), nil
+}
+
+// Type returns the widget's kind (section, text, range, toggle, ...).
+func (w *ConfigWidget) Type() (WidgetType, error) {
+	var cType C.CameraWidgetType
+	if err := cameraResultToError(C.gp_widget_get_type(w.cWidget, &cType)); err != nil {
+		return 0, err
+	}
+	return WidgetType(cType), nil
+}
+
+// Children returns the widget's direct children, e.g. the sections under
+// the configuration root or the settings under a section.
+func (w *ConfigWidget) Children() ([]*ConfigWidget, error) {
+	count := int(C.gp_widget_count_children(w.cWidget))
+	if count < 0 {
+		return nil, cameraResultToError(C.int(count))
+	}
+
+	children := make([]*ConfigWidget, 0, count)
+	for i := 0; i < count; i++ {
+		var cChild *C.CameraWidget
+		if err := cameraResultToError(C.gp_widget_get_child(w.cWidget, C.int(i), &cChild)); err != nil {
+			return nil, err
+		}
+		children = append(children, &ConfigWidget{cWidget: cChild, root: w.root, camera: w.camera})
+	}
+
+	return children, nil
+}
+
+// child looks up a descendant widget by a "/"-separated path such as
+// "/main/imgsettings/iso", walking one gp_widget_get_child_by_name call per
+// path segment starting from this widget.
+func (w *ConfigWidget) child(path string) (*ConfigWidget, error) {
+	cur := w.cWidget
+
+	for _, name := range strings.Split(strings.Trim(path, "/"), "/") {
+		if name == "" {
+			continue
+		}
+
+		cName := C.CString(name)
+		var cChild *C.CameraWidget
+		err := cameraResultToError(C.gp_widget_get_child_by_name(cur, cName, &cChild))
+		C.free(unsafe.Pointer(cName))
+		if err != nil {
+			return nil, err
+		}
+
+		cur = cChild
+	}
+
+	return &ConfigWidget{cWidget: cur, root: w.root, camera: w.camera}, nil
+}
+
+// Get resolves path (e.g. "/main/imgsettings/iso") from this widget and
+// returns its current value: a string for text/radio/menu widgets, a
+// float64 for range widgets, a bool for toggle widgets, and an int for date
+// widgets.
+func (w *ConfigWidget) Get(path string) (any, error) {
+	target, err := w.child(path)
+	if err != nil {
+		return nil, err
+	}
+
+	typ, err := target.Type()
+	if err != nil {
+		return nil, err
+	}
+
+	switch typ {
+	case WidgetText, WidgetRadio, WidgetMenu:
+		var cVal *C.char
+		if err := cameraResultToError(C.gp_widget_get_value(target.cWidget, unsafe.Pointer(&cVal))); err != nil {
+			return nil, err
+		}
+		return C.GoString(cVal), nil
+	case WidgetRange:
+		var cVal C.float
+		if err := cameraResultToError(C.gp_widget_get_value(target.cWidget, unsafe.Pointer(&cVal))); err != nil {
+			return nil, err
+		}
+		return float64(cVal), nil
+	case WidgetToggle:
+		var cVal C.int
+		if err := cameraResultToError(C.gp_widget_get_value(target.cWidget, unsafe.Pointer(&cVal))); err != nil {
+			return nil, err
+		}
+		return cVal != 0, nil
+	case WidgetDate:
+		var cVal C.int
+		if err := cameraResultToError(C.gp_widget_get_value(target.cWidget, unsafe.Pointer(&cVal))); err != nil {
+			return nil, err
+		}
+		return int(cVal), nil
+	default:
+		return nil, fmt.Errorf("gphoto2go: widget type %v has no gettable value", typ)
+	}
+}
+
+// Set resolves path (e.g. "/main/imgsettings/iso") from this widget and
+// stages a new value for it. Call Apply on the root widget (or any widget
+// obtained from the same Config call) to push staged values down to the
+// camera.
+func (w *ConfigWidget) Set(path string, value any) error {
+	target, err := w.child(path)
+	if err != nil {
+		return err
+	}
+
+	typ, err := target.Type()
+	if err != nil {
+		return err
+	}
+
+	switch typ {
+	case WidgetText, WidgetRadio, WidgetMenu:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("gphoto2go: widget expects a string value, got %T", value)
+		}
+		cStr := C.CString(s)
+		defer C.free(unsafe.Pointer(cStr))
+		return cameraResultToError(C.gp_widget_set_value(target.cWidget, unsafe.Pointer(cStr)))
+	case WidgetRange:
+		f, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("gphoto2go: widget expects a float64 value, got %T", value)
+		}
+		cVal := C.float(f)
+		return cameraResultToError(C.gp_widget_set_value(target.cWidget, unsafe.Pointer(&cVal)))
+	case WidgetToggle:
+		b, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("gphoto2go: widget expects a bool value, got %T", value)
+		}
+		cVal := C.int(0)
+		if b {
+			cVal = 1
+		}
+		return cameraResultToError(C.gp_widget_set_value(target.cWidget, unsafe.Pointer(&cVal)))
+	case WidgetDate:
+		i, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("gphoto2go: widget expects an int value, got %T", value)
+		}
+		cVal := C.int(i)
+		return cameraResultToError(C.gp_widget_set_value(target.cWidget, unsafe.Pointer(&cVal)))
+	default:
+		return fmt.Errorf("gphoto2go: widget type %v is not settable", typ)
+	}
+}
+
+// Choices returns the available values for a radio or menu widget.
+func (w *ConfigWidget) Choices() ([]string, error) {
+	count := int(C.gp_widget_count_choices(w.cWidget))
+	if count < 0 {
+		return nil, cameraResultToError(C.int(count))
+	}
+
+	choices := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		var cChoice *C.char
+		if err := cameraResultToError(C.gp_widget_get_choice(w.cWidget, C.int(i), &cChoice)); err != nil {
+			return nil, err
+		}
+		choices = append(choices, C.GoString(cChoice))
+	}
+
+	return choices, nil
+}
+
+// Range returns the (min, max, step) bounds of a range widget.
+func (w *ConfigWidget) Range() (min, max, step float64, err error) {
+	var cMin, cMax, cStep C.float
+	if err := cameraResultToError(C.gp_widget_get_range(w.cWidget, &cMin, &cMax, &cStep)); err != nil {
+		return 0, 0, 0, err
+	}
+	return float64(cMin), float64(cMax), float64(cStep), nil
+}
+
+// Apply pushes the configuration tree, including any values staged via Set
+// on this widget or its descendants, back to the camera.
+func (w *ConfigWidget) Apply() error {
+	w.camera.mu.Lock()
+	defer w.camera.mu.Unlock()
+	return cameraResultToError(C.gp_camera_set_config(w.camera.cCamera, w.root, w.camera.cContext))
+}
@@ -0,0 +1,54 @@
+package gphoto2go
+
+// #cgo pkg-config: libgphoto2
+// #include <gphoto2.h>
+import "C"
+
+// GPhotoError wraps a libgphoto2 GP_ERROR_* result code, keeping the code
+// available for errors.Is/errors.As checks instead of flattening it into a
+// plain string as cameraResultToError used to.
+type GPhotoError struct {
+	Code    int
+	Message string
+}
+
+func (e *GPhotoError) Error() string {
+	return e.Message
+}
+
+// Is reports whether target is a GPhotoError with the same Code, so callers
+// can write errors.Is(err, gphoto2go.ErrCameraBusy) regardless of the
+// Message carried by err.
+func (e *GPhotoError) Is(target error) bool {
+	t, ok := target.(*GPhotoError)
+	if !ok {
+		return false
+	}
+	return e.Code == t.Code
+}
+
+// Unwrap always returns nil: a GPhotoError is constructed directly from a
+// gp_* result code and never wraps another error. It's implemented anyway
+// so GPhotoError satisfies the full errors.Is/errors.As contract, including
+// when a caller wraps it further with fmt.Errorf("...: %w", err).
+func (e *GPhotoError) Unwrap() error {
+	return nil
+}
+
+// Sentinel errors for the libgphoto2 result codes callers most commonly
+// need to branch on, e.g. to retry gp_camera_capture while the camera
+// reports itself busy. Message is populated from gp_result_as_string so the
+// sentinels are usable as real errors, not just as errors.Is targets.
+var (
+	ErrNotSupported  = newSentinelError(C.GP_ERROR_NOT_SUPPORTED)
+	ErrIO            = newSentinelError(C.GP_ERROR_IO)
+	ErrTimeout       = newSentinelError(C.GP_ERROR_TIMEOUT)
+	ErrModelNotFound = newSentinelError(C.GP_ERROR_MODEL_NOT_FOUND)
+	ErrCameraBusy    = newSentinelError(C.GP_ERROR_CAMERA_BUSY)
+	ErrCancel        = newSentinelError(C.GP_ERROR_CANCEL)
+	ErrFileNotFound  = newSentinelError(C.GP_ERROR_FILE_NOT_FOUND)
+)
+
+func newSentinelError(code C.int) *GPhotoError {
+	return &GPhotoError{Code: int(code), Message: C.GoString(C.gp_result_as_string(code))}
+}
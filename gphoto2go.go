@@ -5,7 +5,8 @@ package gphoto2go
 // #include <stdlib.h>
 import "C"
 import (
-	"fmt"
+	"context"
+	"sync"
 	"unsafe"
 )
 
@@ -15,19 +16,227 @@ const (
 	CAPTURE_SOUND = C.GP_CAPTURE_SOUND
 )
 
+// eventWaitTimeoutMs is the poll interval passed to gp_camera_wait_for_event.
+// It has to be finite so the Events loop gets a chance to notice ctx
+// cancellation between events.
+const eventWaitTimeoutMs = 1000
+
 // CameraEventType code
 type CameraEventType int
 
 const (
-	EventUnknown   CameraEventType = C.GP_EVENT_UNKNOWN
-	EventTimeout   CameraEventType = C.GP_EVENT_TIMEOUT
-	EventFileAdded CameraEventType = C.GP_EVENT_FILE_ADDED
+	EventUnknown         CameraEventType = C.GP_EVENT_UNKNOWN
+	EventTimeout         CameraEventType = C.GP_EVENT_TIMEOUT
+	EventFileAdded       CameraEventType = C.GP_EVENT_FILE_ADDED
+	EventFolderAdded     CameraEventType = C.GP_EVENT_FOLDER_ADDED
+	EventCaptureComplete CameraEventType = C.GP_EVENT_CAPTURE_COMPLETE
+	// EventFileChanged is the closest thing modern libgphoto2 has to a
+	// generic property-change notification: it fires when an existing file
+	// on the camera is modified in place.
+	EventFileChanged CameraEventType = C.GP_EVENT_FILE_CHANGED
 )
 
 type CameraEvent struct {
 	Type   CameraEventType
 	Folder string
 	File   string
+	// Err is set when the event loop itself failed (e.g. the camera was
+	// disconnected); Type is EventUnknown in that case.
+	Err error
+}
+
+// Camera wraps a native libgphoto2 camera handle together with the gp
+// context calls against it require. libgphoto2 is not thread-safe per
+// camera, so every native call against cCamera/cContext must go through mu
+// -- sessionCamera relies on this same lock rather than keeping its own.
+type Camera struct {
+	mu       sync.Mutex
+	cCamera  *C.Camera
+	cContext *C.GPContext
+}
+
+// NewCamera opens the first camera libgphoto2 can find on the bus.
+func NewCamera() (*Camera, error) {
+	cContext := C.gp_context_new()
+
+	var cCamera *C.Camera
+	if err := cameraResultToError(C.gp_camera_new(&cCamera)); err != nil {
+		return nil, err
+	}
+
+	if err := cameraResultToError(C.gp_camera_init(cCamera, cContext)); err != nil {
+		return nil, err
+	}
+
+	return &Camera{cCamera: cCamera, cContext: cContext}, nil
+}
+
+// Close releases the underlying camera handle, freeing both the native
+// Camera and GPContext.
+func (c *Camera) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	err := cameraResultToError(C.gp_camera_exit(c.cCamera, c.cContext))
+	C.gp_camera_unref(c.cCamera)
+	C.gp_context_unref(c.cContext)
+	return err
+}
+
+// Events starts a background goroutine that repeatedly calls
+// gp_camera_wait_for_event and streams decoded events on the returned
+// channel. The loop, and therefore the channel, stops as soon as ctx is
+// canceled or the camera reports an error; the channel is always closed on
+// exit so callers can simply range over it. A camera-level error is
+// delivered as one last CameraEvent with Err set rather than on a separate
+// channel, since the two can never race with each other.
+func (c *Camera) Events(ctx context.Context) (<-chan CameraEvent, error) {
+	events := make(chan CameraEvent)
+
+	go func() {
+		defer close(events)
+
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+
+			var cEventType C.CameraEventType
+			var cEventData unsafe.Pointer
+
+			c.mu.Lock()
+			err := cameraResultToError(C.gp_camera_wait_for_event(
+				c.cCamera, C.int(eventWaitTimeoutMs), &cEventType, &cEventData, c.cContext))
+			c.mu.Unlock()
+			if err != nil {
+				select {
+				case events <- CameraEvent{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			event := cCameraEventToGoCameraEvent(cEventData, cEventType)
+			if cEventData != nil {
+				C.free(cEventData)
+			}
+
+			if event.Type == EventTimeout {
+				continue
+			}
+
+			select {
+			case events <- *event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// FanOutEvents copies every CameraEvent read from in to n independent output
+// channels, so that multiple consumers (e.g. a tethered-capture UI and a
+// logger) can each range over their own channel at their own pace. Each
+// output is served by its own eventRelay with an unbounded backlog, so a
+// consumer that stops draining its channel only grows its own backlog
+// instead of blocking delivery to the others. All outputs are closed once
+// in is closed or ctx is canceled.
+func FanOutEvents(ctx context.Context, in <-chan CameraEvent, n int) []<-chan CameraEvent {
+	relays := make([]*eventRelay, n)
+	outs := make([]<-chan CameraEvent, n)
+	for i := range relays {
+		relays[i] = newEventRelay(ctx)
+		outs[i] = relays[i].out
+	}
+
+	go func() {
+		defer func() {
+			for _, r := range relays {
+				r.closeInput()
+			}
+		}()
+
+		for {
+			select {
+			case ev, ok := <-in:
+				if !ok {
+					return
+				}
+				for _, r := range relays {
+					r.push(ev)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return outs
+}
+
+// eventRelay decouples one FanOutEvents consumer from the others: push
+// never blocks, queuing onto its own backlog instead, so a stalled reader on
+// out only delays that reader's own events rather than every consumer's.
+type eventRelay struct {
+	ctx    context.Context
+	mu     sync.Mutex
+	cond   *sync.Cond
+	queue  []CameraEvent
+	closed bool
+	out    chan CameraEvent
+}
+
+func newEventRelay(ctx context.Context) *eventRelay {
+	r := &eventRelay{ctx: ctx, out: make(chan CameraEvent)}
+	r.cond = sync.NewCond(&r.mu)
+
+	go r.run()
+	go func() {
+		<-ctx.Done()
+		r.closeInput()
+	}()
+
+	return r
+}
+
+func (r *eventRelay) push(ev CameraEvent) {
+	r.mu.Lock()
+	r.queue = append(r.queue, ev)
+	r.mu.Unlock()
+	r.cond.Signal()
+}
+
+func (r *eventRelay) closeInput() {
+	r.mu.Lock()
+	r.closed = true
+	r.mu.Unlock()
+	r.cond.Signal()
+}
+
+func (r *eventRelay) run() {
+	defer close(r.out)
+
+	for {
+		r.mu.Lock()
+		for len(r.queue) == 0 && !r.closed {
+			r.cond.Wait()
+		}
+		if len(r.queue) == 0 {
+			r.mu.Unlock()
+			return
+		}
+		ev := r.queue[0]
+		r.queue = r.queue[1:]
+		r.mu.Unlock()
+
+		select {
+		case r.out <- ev:
+		case <-r.ctx.Done():
+			return
+		}
+	}
 }
 
 type CameraFilePath struct {
@@ -39,7 +248,7 @@ func cCameraEventToGoCameraEvent(voidPtr unsafe.Pointer, eventType C.CameraEvent
 	ce := new(CameraEvent)
 	ce.Type = CameraEventType(eventType)
 
-	if ce.Type == EventFileAdded {
+	if ce.Type == EventFileAdded || ce.Type == EventFolderAdded || ce.Type == EventFileChanged {
 		cameraFilePath := (*C.CameraFilePath)(voidPtr)
 		ce.File = C.GoString((*C.char)(&cameraFilePath.name[0]))
 		ce.Folder = C.GoString((*C.char)(&cameraFilePath.folder[0]))
@@ -48,34 +257,9 @@ func cCameraEventToGoCameraEvent(voidPtr unsafe.Pointer, eventType C.CameraEvent
 	return ce
 }
 
-func cameraListToMap(cameraList *C.CameraList) (map[string]string, int) {
-	size := int(C.gp_list_count(cameraList))
-	vals := make(map[string]string)
-
-	if size < 0 {
-		return vals, size
-	}
-
-	for i := 0; i < size; i++ {
-		var cKey *C.char
-		var cVal *C.char
-
-		C.gp_list_get_name(cameraList, C.int(i), &cKey)
-		C.gp_list_get_value(cameraList, C.int(i), &cVal)
-		defer C.free(unsafe.Pointer(cKey))
-		defer C.free(unsafe.Pointer(cVal))
-		key := C.GoString(cKey)
-		val := C.GoString(cVal)
-
-		vals[key] = val
-	}
-
-	return vals, 0
-}
-
 func cameraResultToError(err C.int) error {
 	if err != 0 {
-		return fmt.Errorf(C.GoString(C.gp_result_as_string(err)))
+		return &GPhotoError{Code: int(err), Message: C.GoString(C.gp_result_as_string(err))}
 	}
 	return nil
 }
@@ -84,4 +268,3 @@ func cameraResultToError(err C.int) error {
 func CameraResultToString(err C.int) string {
 	return C.GoString(C.gp_result_as_string(err))
 }
-
@@ -0,0 +1,129 @@
+package gphoto2go
+
+// #cgo pkg-config: libgphoto2
+// #include <gphoto2.h>
+// #include <stdlib.h>
+import "C"
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"unsafe"
+)
+
+// capturePreviewInto triggers a single gp_camera_capture_preview into cFile
+// and copies the resulting JPEG out into a Go-owned byte slice, so the
+// caller is free to reset or unref cFile afterwards.
+func (c *Camera) capturePreviewInto(cFile *C.CameraFile) ([]byte, error) {
+	c.mu.Lock()
+	err := cameraResultToError(C.gp_camera_capture_preview(c.cCamera, cFile, c.cContext))
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	var cData *C.char
+	var cSize C.ulong
+	if err := cameraResultToError(C.gp_file_get_data_and_size(cFile, &cData, &cSize)); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, int(cSize))
+	copy(buf, unsafe.Slice((*byte)(unsafe.Pointer(cData)), int(cSize)))
+	return buf, nil
+}
+
+// Preview captures a single liveview frame and returns it as a JPEG
+// io.ReadCloser. The frame is copied into Go memory and the underlying
+// CameraFile is released before Preview returns, so Close is a no-op.
+func (c *Camera) Preview() (io.ReadCloser, error) {
+	var cFile *C.CameraFile
+	if err := cameraResultToError(C.gp_file_new(&cFile)); err != nil {
+		return nil, err
+	}
+
+	frame, err := c.capturePreviewInto(cFile)
+	C.gp_file_unref(cFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(frame)), nil
+}
+
+// PreviewStream starts a background goroutine that repeatedly captures
+// preview frames and streams them as JPEG byte slices until ctx is
+// canceled or a frame fails to capture; the channel is closed in either
+// case, and the capture error itself is not reported. A single CameraFile is allocated once and reset between frames
+// (gp_file_clean) so a long-running liveview doesn't malloc/free on every
+// frame.
+func (c *Camera) PreviewStream(ctx context.Context) (<-chan []byte, error) {
+	var cFile *C.CameraFile
+	if err := cameraResultToError(C.gp_file_new(&cFile)); err != nil {
+		return nil, err
+	}
+
+	frames := make(chan []byte)
+
+	go func() {
+		defer close(frames)
+		defer C.gp_file_unref(cFile)
+
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+
+			frame, err := c.capturePreviewInto(cFile)
+			if err != nil {
+				return
+			}
+
+			select {
+			case frames <- frame:
+			case <-ctx.Done():
+				return
+			}
+
+			C.gp_file_clean(cFile)
+		}
+	}()
+
+	return frames, nil
+}
+
+// ServePreviewMJPEG streams the camera's liveview to w as a
+// "multipart/x-mixed-replace" MJPEG response, suitable for wiring directly
+// into an HTML <img> tag. It blocks until the request context is canceled
+// or a preview frame fails to capture.
+func (c *Camera) ServePreviewMJPEG(w http.ResponseWriter, r *http.Request) error {
+	const boundary = "gphoto2go-preview"
+
+	frames, err := c.PreviewStream(r.Context())
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", fmt.Sprintf("multipart/x-mixed-replace; boundary=%s", boundary))
+
+	flusher, _ := w.(http.Flusher)
+
+	for frame := range frames {
+		if _, err := fmt.Fprintf(w, "--%s\r\nContent-Type: image/jpeg\r\nContent-Length: %d\r\n\r\n", boundary, len(frame)); err != nil {
+			return err
+		}
+		if _, err := w.Write(frame); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, "\r\n"); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,346 @@
+package gphoto2go
+
+// #cgo pkg-config: libgphoto2
+// #include <gphoto2.h>
+// #include <stdlib.h>
+import "C"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+	"unsafe"
+)
+
+// CameraInfo describes one camera gp_camera_autodetect found on the bus,
+// without opening it.
+type CameraInfo struct {
+	Model string
+	Port  string
+}
+
+// Detect lists the cameras libgphoto2 can currently see. It walks the
+// CameraList by position rather than collapsing it into a map keyed by
+// model, so a rig with two cameras of the same model (a common
+// multi-camera setup) isn't silently deduplicated down to one.
+func Detect() ([]CameraInfo, error) {
+	cContext := C.gp_context_new()
+	defer C.gp_context_unref(cContext)
+
+	var cList *C.CameraList
+	if err := cameraResultToError(C.gp_list_new(&cList)); err != nil {
+		return nil, err
+	}
+	defer C.gp_list_free(cList)
+
+	if err := cameraResultToError(C.gp_camera_autodetect(cList, cContext)); err != nil {
+		return nil, err
+	}
+
+	size := int(C.gp_list_count(cList))
+	if size < 0 {
+		return nil, cameraResultToError(C.int(size))
+	}
+
+	infos := make([]CameraInfo, 0, size)
+	for i := 0; i < size; i++ {
+		var cModel *C.char
+		var cPort *C.char
+
+		C.gp_list_get_name(cList, C.int(i), &cModel)
+		C.gp_list_get_value(cList, C.int(i), &cPort)
+
+		infos = append(infos, CameraInfo{Model: C.GoString(cModel), Port: C.GoString(cPort)})
+	}
+
+	return infos, nil
+}
+
+// newCameraAt opens the camera with the given model at the given port,
+// unlike NewCamera which just grabs whatever camera libgphoto2 finds first.
+// This is what lets a Session hold more than one camera open at once.
+func newCameraAt(info CameraInfo) (*Camera, error) {
+	cModel := C.CString(info.Model)
+	defer C.free(unsafe.Pointer(cModel))
+	cPort := C.CString(info.Port)
+	defer C.free(unsafe.Pointer(cPort))
+
+	var cAbilitiesList *C.CameraAbilitiesList
+	if err := cameraResultToError(C.gp_abilities_list_new(&cAbilitiesList)); err != nil {
+		return nil, err
+	}
+	defer C.gp_abilities_list_free(cAbilitiesList)
+
+	cContext := C.gp_context_new()
+	// ownsContext tracks whether newCameraAt is still responsible for
+	// releasing cContext: ownership transfers to the returned Camera (freed
+	// by Close) only once construction fully succeeds.
+	ownsContext := true
+	defer func() {
+		if ownsContext {
+			C.gp_context_unref(cContext)
+		}
+	}()
+
+	if err := cameraResultToError(C.gp_abilities_list_load(cAbilitiesList, cContext)); err != nil {
+		return nil, err
+	}
+
+	modelIdx := C.gp_abilities_list_lookup_model(cAbilitiesList, cModel)
+	if modelIdx < 0 {
+		return nil, cameraResultToError(modelIdx)
+	}
+
+	var cAbilities C.CameraAbilities
+	if err := cameraResultToError(C.gp_abilities_list_get_abilities(cAbilitiesList, modelIdx, &cAbilities)); err != nil {
+		return nil, err
+	}
+
+	var cPortInfoList *C.GPPortInfoList
+	if err := cameraResultToError(C.gp_port_info_list_new(&cPortInfoList)); err != nil {
+		return nil, err
+	}
+	defer C.gp_port_info_list_free(cPortInfoList)
+
+	if err := cameraResultToError(C.gp_port_info_list_load(cPortInfoList)); err != nil {
+		return nil, err
+	}
+
+	portIdx := C.gp_port_info_list_lookup_path(cPortInfoList, cPort)
+	if portIdx < 0 {
+		return nil, cameraResultToError(portIdx)
+	}
+
+	var cPortInfo C.GPPortInfo
+	if err := cameraResultToError(C.gp_port_info_list_get_info(cPortInfoList, portIdx, &cPortInfo)); err != nil {
+		return nil, err
+	}
+
+	var cCamera *C.Camera
+	if err := cameraResultToError(C.gp_camera_new(&cCamera)); err != nil {
+		return nil, err
+	}
+
+	if err := cameraResultToError(C.gp_camera_set_abilities(cCamera, cAbilities)); err != nil {
+		C.gp_camera_unref(cCamera)
+		return nil, err
+	}
+
+	if err := cameraResultToError(C.gp_camera_set_port_info(cCamera, cPortInfo)); err != nil {
+		C.gp_camera_unref(cCamera)
+		return nil, err
+	}
+
+	if err := cameraResultToError(C.gp_camera_init(cCamera, cContext)); err != nil {
+		C.gp_camera_unref(cCamera)
+		return nil, err
+	}
+
+	ownsContext = false
+	return &Camera{cCamera: cCamera, cContext: cContext}, nil
+}
+
+// sessionCamera pairs an open Camera with the CameraInfo it was opened
+// with. Locking is Camera.mu's job -- capture goes through it directly
+// rather than keeping a second, independent lock around the same handle.
+type sessionCamera struct {
+	camera *Camera
+	info   CameraInfo
+}
+
+func (sc *sessionCamera) capture() (CameraFilePath, error) {
+	sc.camera.mu.Lock()
+	defer sc.camera.mu.Unlock()
+
+	var cPath C.CameraFilePath
+	err := cameraResultToError(C.gp_camera_capture(
+		sc.camera.cCamera, C.GP_CAPTURE_IMAGE, &cPath, sc.camera.cContext))
+	if err != nil {
+		return CameraFilePath{}, err
+	}
+
+	return CameraFilePath{
+		Name:   C.GoString((*C.char)(&cPath.name[0])),
+		Folder: C.GoString((*C.char)(&cPath.folder[0])),
+	}, nil
+}
+
+// HotplugEvent reports that a camera was plugged in or unplugged since the
+// last rescan.
+type HotplugEvent struct {
+	Info  CameraInfo
+	Added bool
+}
+
+// Session owns every camera currently plugged in, keyed by port, and lets
+// callers trigger operations across all of them at once. This is the
+// building block for multi-camera rigs such as photogrammetry or
+// bullet-time setups.
+type Session struct {
+	mu      sync.Mutex
+	cameras map[string]*sessionCamera
+}
+
+// NewSession opens every camera gp_camera_autodetect can currently see.
+func NewSession() (*Session, error) {
+	s := &Session{cameras: make(map[string]*sessionCamera)}
+
+	infos, err := Detect()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range infos {
+		if err := s.open(info); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+func (s *Session) open(info CameraInfo) error {
+	camera, err := newCameraAt(info)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.cameras[info.Port] = &sessionCamera{camera: camera, info: info}
+	s.mu.Unlock()
+
+	return nil
+}
+
+func (s *Session) close(port string) error {
+	s.mu.Lock()
+	sc, ok := s.cameras[port]
+	delete(s.cameras, port)
+	s.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	return sc.camera.Close()
+}
+
+// Cameras returns the CameraInfo for every camera currently held open by the
+// session.
+func (s *Session) Cameras() []CameraInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	infos := make([]CameraInfo, 0, len(s.cameras))
+	for _, sc := range s.cameras {
+		infos = append(infos, sc.info)
+	}
+	return infos
+}
+
+// SyncCapture triggers gp_camera_capture on every camera in the session at
+// (as close to) the same time as possible, returning the resulting file
+// path keyed by port. Errors from individual cameras are joined rather than
+// aborting the other captures.
+func (s *Session) SyncCapture() (map[string]CameraFilePath, error) {
+	s.mu.Lock()
+	cameras := make([]*sessionCamera, 0, len(s.cameras))
+	for _, sc := range s.cameras {
+		cameras = append(cameras, sc)
+	}
+	s.mu.Unlock()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		paths   = make(map[string]CameraFilePath, len(cameras))
+		allErrs []error
+	)
+
+	wg.Add(len(cameras))
+	for _, sc := range cameras {
+		go func(sc *sessionCamera) {
+			defer wg.Done()
+
+			path, err := sc.capture()
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				allErrs = append(allErrs, fmt.Errorf("%s: %w", sc.info.Port, err))
+				return
+			}
+			paths[sc.info.Port] = path
+		}(sc)
+	}
+	wg.Wait()
+
+	return paths, errors.Join(allErrs...)
+}
+
+// Watch starts a background goroutine that rescans the bus on every tick of
+// interval, opens any newly connected cameras, closes any that disappeared,
+// and reports both as HotplugEvents. The returned channel is closed once
+// ctx is canceled.
+func (s *Session) Watch(ctx context.Context, interval time.Duration) <-chan HotplugEvent {
+	events := make(chan HotplugEvent)
+
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			infos, err := Detect()
+			if err != nil {
+				continue
+			}
+
+			seen := make(map[string]bool, len(infos))
+			for _, info := range infos {
+				seen[info.Port] = true
+
+				s.mu.Lock()
+				_, known := s.cameras[info.Port]
+				s.mu.Unlock()
+				if known {
+					continue
+				}
+
+				if err := s.open(info); err != nil {
+					continue
+				}
+
+				select {
+				case events <- HotplugEvent{Info: info, Added: true}:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			for _, info := range s.Cameras() {
+				if seen[info.Port] {
+					continue
+				}
+
+				_ = s.close(info.Port)
+
+				select {
+				case events <- HotplugEvent{Info: info, Added: false}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events
+}